@@ -0,0 +1,36 @@
+package cron
+
+import "time"
+
+// dateIn builds a time for the given wall clock fields in loc, the same way
+// time.Date does, but guards against DST spring-forward gaps: on a day
+// where clocks jump forward, some wall-clock times never occur and Go
+// normalizes them to a different instant instead of failing, silently
+// shifting the result. dateIn detects that shift and instead returns the
+// first wall clock on or after the requested one that actually exists in
+// loc, along with skipped=true so the caller knows the returned instant is
+// a substitute for an imaginary one rather than an exact match.
+//
+// Fall-back (an hour that occurs twice) needs no special handling here:
+// time.Date resolves it to one of the two instants (the earlier one in
+// America/Los_Angeles, the later one in Europe/Berlin), and since next()
+// only ever advances past the previous result, that instant is produced
+// exactly once and the other occurrence is never produced.
+func dateIn(year int, month time.Month, day, hour, min int, loc *time.Location) (cand time.Time, skipped bool) {
+	cand = time.Date(year, month, day, hour, min, 0, 0, loc)
+	if cand.Hour() == hour && cand.Minute() == min {
+		return cand, false
+	}
+
+	// The requested wall clock doesn't exist (spring-forward gap): walk
+	// forward from midnight until we land on or past it.
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	end := t.AddDate(0, 0, 1)
+	for t.Before(end) {
+		if t.Hour() > hour || (t.Hour() == hour && t.Minute() >= min) {
+			return t, true
+		}
+		t = t.Add(time.Minute)
+	}
+	return end, true
+}
@@ -0,0 +1,121 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func mustParseInLocation(t *testing.T, value string, loc *time.Location) time.Time {
+	t.Helper()
+	parsed, err := time.ParseInLocation("2006-01-02 15:04:05", value, loc)
+	if err != nil {
+		t.Fatalf("failed to parse time %s: %v", value, err)
+	}
+	return parsed
+}
+
+// TestCronSerieDST exercises NewCronSerieInLocation across the spring-forward
+// and fall-back DST transitions, for both America/Los_Angeles and
+// Europe/Berlin, in 2019 and 2024.
+func TestCronSerieDST(t *testing.T) {
+	la := mustLoadLocation(t, "America/Los_Angeles")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	cases := []struct {
+		name string
+		expr string
+		loc  *time.Location
+		// after and want are formatted "2006-01-02 15:04:05" and parsed in
+		// loc.
+		after string
+		want  string
+	}{
+		{
+			name:  "LA 2024 spring-forward gap fires at the first valid instant",
+			expr:  "0 2 * * *",
+			loc:   la,
+			after: "2024-03-09 12:00:00", // the day before the transition
+			want:  "2024-03-10 03:00:00", // 2:00 doesn't exist; clocks jump 2->3
+		},
+		{
+			name:  "LA 2024 fall-back ambiguous hour fires only once",
+			expr:  "0 1 * * *",
+			loc:   la,
+			after: "2024-11-02 12:00:00",
+			want:  "2024-11-03 01:00:00", // first (PDT) occurrence of 1:00
+		},
+		{
+			name:  "LA 2024 fall-back does not re-fire on the repeated hour",
+			expr:  "0 1 * * *",
+			loc:   la,
+			after: "2024-11-03 01:00:00", // the first occurrence of 1:00
+			want:  "2024-11-04 01:00:00", // skips the repeated 1:00 PST same day
+		},
+		{
+			name:  "LA 2019 spring-forward gap fires at the first valid instant",
+			expr:  "0 2 * * *",
+			loc:   la,
+			after: "2019-03-09 12:00:00",
+			want:  "2019-03-10 03:00:00",
+		},
+		{
+			name:  "LA 2019 fall-back ambiguous hour fires only once",
+			expr:  "0 1 * * *",
+			loc:   la,
+			after: "2019-11-02 12:00:00",
+			want:  "2019-11-03 01:00:00",
+		},
+		{
+			name:  "Berlin 2024 spring-forward gap fires at the first valid instant",
+			expr:  "30 2 * * *",
+			loc:   berlin,
+			after: "2024-03-30 12:00:00",
+			want:  "2024-03-31 03:30:00", // 2:30 doesn't exist; clocks jump 2->3, so the :30 fires at 3:30
+		},
+		{
+			name:  "Berlin 2024 fall-back ambiguous hour fires only once",
+			expr:  "30 2 * * *",
+			loc:   berlin,
+			after: "2024-10-26 12:00:00",
+			want:  "2024-10-27 02:30:00", // second (CET) occurrence of 2:30, the one time.Date picks
+		},
+		{
+			name:  "Berlin 2019 spring-forward gap fires at the first valid instant",
+			expr:  "30 2 * * *",
+			loc:   berlin,
+			after: "2019-03-30 12:00:00",
+			want:  "2019-03-31 03:30:00",
+		},
+		{
+			name:  "Berlin 2019 fall-back ambiguous hour fires only once",
+			expr:  "30 2 * * *",
+			loc:   berlin,
+			after: "2019-10-26 12:00:00",
+			want:  "2019-10-27 02:30:00",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			serie, err := NewCronSerieInLocation(c.expr, c.loc)
+			if err != nil {
+				t.Fatalf("failed to create cron serie: %v", err)
+			}
+			after := mustParseInLocation(t, c.after, c.loc)
+			want := mustParseInLocation(t, c.want, c.loc)
+			got := serie.Next(after)
+			assert.True(t, want.Equal(got), "want %v, got %v", want, got)
+		})
+	}
+}
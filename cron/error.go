@@ -0,0 +1,29 @@
+package cron
+
+import "fmt"
+
+// ParseError describes why a cron expression failed to parse, in enough
+// detail for a caller to point a user at the offending part of the string
+// (e.g. to underline it in an admin UI).
+type ParseError struct {
+	// Field is the cron field the error was found in: "second" (only when
+	// parsing with WithSeconds), "minute", "hour", "dom", "month" or "dow".
+	// For errors about the expression as a whole (e.g. the wrong number of
+	// fields), Field is "expression".
+	Field string
+
+	// Token is the specific sub-expression that failed to parse. For a
+	// month or dow field written with names (e.g. "JAN-FOO"), Token is the
+	// whole field as written.
+	Token string
+
+	// Position is the byte offset of Token within the original expression.
+	Position int
+
+	// Reason is a short, human-readable explanation.
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cron: invalid %s field %q at position %d: %s", e.Field, e.Token, e.Position, e.Reason)
+}
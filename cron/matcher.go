@@ -0,0 +1,108 @@
+package cron
+
+import "time"
+
+// domMatcher decides whether a given day of month matches a day-of-month
+// field. Unlike the other fields, day-of-month specifiers can depend on the
+// month actually being evaluated (e.g. "L" for the last day of the month,
+// or "15W" for the nearest weekday to the 15th), so a fixed boolean array
+// isn't enough to represent them.
+type domMatcher interface {
+	// match reports whether t's day of month satisfies the field.
+	match(t time.Time) bool
+
+	// firstMatch returns the first day of month (1-based) that satisfies
+	// the field for the given year and month, or 0 if there is none.
+	firstMatch(year int, month time.Month) int
+}
+
+// arrayDomMatcher is the domMatcher for plain lists, ranges, steps and
+// wildcards, backed by a [32]bool array, the same boolean-array
+// representation used by the other fields.
+type arrayDomMatcher struct {
+	days [32]bool
+}
+
+func (m *arrayDomMatcher) match(t time.Time) bool {
+	return m.days[t.Day()]
+}
+
+func (m *arrayDomMatcher) firstMatch(year int, month time.Month) int {
+	for d := 1; d <= daysInMonth(year, month); d++ {
+		if m.days[d] {
+			return d
+		}
+	}
+	return 0
+}
+
+// lastDayMatcher implements the bare "L" token: the last day of the month.
+type lastDayMatcher struct{}
+
+func (lastDayMatcher) match(t time.Time) bool {
+	return t.Day() == daysInMonth(t.Year(), t.Month())
+}
+
+func (lastDayMatcher) firstMatch(year int, month time.Month) int {
+	return daysInMonth(year, month)
+}
+
+// lastDayOffsetMatcher implements "L-n": n days before the end of the
+// month.
+type lastDayOffsetMatcher struct {
+	offset int
+}
+
+func (m lastDayOffsetMatcher) dayFor(year int, month time.Month) int {
+	d := daysInMonth(year, month) - m.offset
+	if d < 1 {
+		d = 1
+	}
+	return d
+}
+
+func (m lastDayOffsetMatcher) match(t time.Time) bool {
+	return t.Day() == m.dayFor(t.Year(), t.Month())
+}
+
+func (m lastDayOffsetMatcher) firstMatch(year int, month time.Month) int {
+	return m.dayFor(year, month)
+}
+
+// nearestWeekdayMatcher implements "nW": the weekday (Mon-Fri) nearest to
+// the nth day of the month. It never crosses into the previous or next
+// month.
+type nearestWeekdayMatcher struct {
+	day int
+}
+
+func (m nearestWeekdayMatcher) dayFor(year int, month time.Month) int {
+	days := daysInMonth(year, month)
+	target := m.day
+	if target > days {
+		target = days
+	}
+	switch time.Date(year, month, target, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if target > 1 {
+			target--
+		} else {
+			target += 2
+		}
+	case time.Sunday:
+		if target < days {
+			target++
+		} else {
+			target -= 2
+		}
+	}
+	return target
+}
+
+func (m nearestWeekdayMatcher) match(t time.Time) bool {
+	return t.Day() == m.dayFor(t.Year(), t.Month())
+}
+
+func (m nearestWeekdayMatcher) firstMatch(year int, month time.Month) int {
+	return m.dayFor(year, month)
+}
@@ -0,0 +1,76 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseOption configures how NewCronSerieWithOptions interprets an
+// expression.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	seconds     bool
+	descriptors bool
+}
+
+// WithSeconds enables a leading seconds field (0-59), making
+// NewCronSerieWithOptions expect 6 fields instead of the standard 5.
+func WithSeconds() ParseOption {
+	return func(c *parseConfig) {
+		c.seconds = true
+	}
+}
+
+// WithDescriptors enables the "@yearly", "@annually", "@monthly", "@weekly",
+// "@daily", "@hourly" and "@every <duration>" descriptors in place of a
+// field-based expression.
+func WithDescriptors() ParseOption {
+	return func(c *parseConfig) {
+		c.descriptors = true
+	}
+}
+
+// descriptors maps the fixed-schedule descriptors to their equivalent
+// 5-field cron expression. "@every" isn't listed here since it isn't
+// expressible as a CronSerie; it's handled separately as a ConstantDelay.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// NewCronSerieWithOptions parses expr according to opts and returns the
+// resulting Schedule. Without WithSeconds, expr is a standard 5-field cron
+// expression; with it, expr takes a leading seconds field. With
+// WithDescriptors, expr may instead be one of the "@..." descriptors, in
+// which case the result is either the CronSerie for its equivalent
+// expression or, for "@every <duration>", a ConstantDelay.
+func NewCronSerieWithOptions(expr string, opts ...ParseOption) (Schedule, error) {
+	cfg := &parseConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.descriptors {
+		if rest, ok := strings.CutPrefix(expr, "@every "); ok {
+			delay, err := time.ParseDuration(rest)
+			if err != nil {
+				return nil, fmt.Errorf("invalid @every duration: %w", err)
+			}
+			return ConstantDelay{Delay: delay}, nil
+		}
+		if translated, ok := descriptors[expr]; ok {
+			expr = translated
+			if cfg.seconds {
+				expr = "0 " + expr
+			}
+		}
+	}
+
+	return newCronSerie(expr, nil, cfg.seconds)
+}
@@ -0,0 +1,113 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCronSerieWithOptionsSeconds(t *testing.T) {
+	schedule, err := NewCronSerieWithOptions("30 5 * * * *", WithSeconds())
+	if err != nil {
+		t.Fatalf("failed to create cron serie: %v", err)
+	}
+	after := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 12:01:00")
+	got := schedule.Next(after)
+	want := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 12:05:30")
+	assert.Equal(t, want, got)
+}
+
+func TestNewCronSerieWithOptionsSecondsRejectsFiveFields(t *testing.T) {
+	_, err := NewCronSerieWithOptions("5 * * * *", WithSeconds())
+	assert.Error(t, err)
+}
+
+func TestNewCronSerieWithOptionsDescriptors(t *testing.T) {
+	cases := []struct {
+		name  string
+		expr  string
+		after string
+		want  string
+	}{
+		{
+			name:  "@yearly",
+			expr:  "@yearly",
+			after: "2025-03-01 00:00:00",
+			want:  "2026-01-01 00:00:00",
+		},
+		{
+			name:  "@annually",
+			expr:  "@annually",
+			after: "2025-03-01 00:00:00",
+			want:  "2026-01-01 00:00:00",
+		},
+		{
+			name:  "@monthly",
+			expr:  "@monthly",
+			after: "2025-08-15 00:00:00",
+			want:  "2025-09-01 00:00:00",
+		},
+		{
+			name:  "@weekly",
+			expr:  "@weekly",
+			after: "2025-08-13 00:00:00",
+			want:  "2025-08-17 00:00:00",
+		},
+		{
+			name:  "@daily",
+			expr:  "@daily",
+			after: "2025-08-15 12:00:00",
+			want:  "2025-08-16 00:00:00",
+		},
+		{
+			name:  "@hourly",
+			expr:  "@hourly",
+			after: "2025-08-15 12:30:00",
+			want:  "2025-08-15 13:00:00",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			schedule, err := NewCronSerieWithOptions(c.expr, WithDescriptors())
+			if err != nil {
+				t.Fatalf("failed to create schedule: %v", err)
+			}
+			after := mustParseTime(t, "2006-01-02 15:04:05", c.after)
+			want := mustParseTime(t, "2006-01-02 15:04:05", c.want)
+			assert.Equal(t, want, schedule.Next(after))
+		})
+	}
+}
+
+func TestNewCronSerieWithOptionsEvery(t *testing.T) {
+	schedule, err := NewCronSerieWithOptions("@every 1h30m", WithDescriptors())
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+	if _, ok := schedule.(ConstantDelay); !ok {
+		t.Fatalf("expected a ConstantDelay, got %T", schedule)
+	}
+	after := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 12:00:00")
+	want := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 13:30:00")
+	assert.Equal(t, want, schedule.Next(after))
+}
+
+func TestParseFieldAcceptsMonthAndWeekdayNames(t *testing.T) {
+	serie, err := NewCronSerie("0 0 1 JAN-MAR MON")
+	if err != nil {
+		t.Fatalf("failed to create cron serie: %v", err)
+	}
+	after := mustParseTime(t, "2006-01-02 15:04:05", "2025-01-01 00:00:00")
+	got := serie.Next(after)
+	want := mustParseTime(t, "2006-01-02 15:04:05", "2027-02-01 00:00:00") // first Jan-Mar 1st that's a Monday
+	assert.Equal(t, want, got)
+}
+
+func TestConstantDelayNext(t *testing.T) {
+	delay := ConstantDelay{Delay: 10 * time.Minute}
+	after := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 12:00:00")
+	want := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 12:10:00")
+	assert.Equal(t, want, delay.Next(after))
+}
@@ -0,0 +1,22 @@
+package cron
+
+import "time"
+
+// Schedule is satisfied by anything that can compute its own next run time
+// after a given instant. CronSerie implements it directly; ConstantDelay
+// implements it for schedules, like "@every <duration>", that don't fit the
+// cron field model.
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+// ConstantDelay is a Schedule that fires every Delay, regardless of
+// wall-clock alignment. It backs the "@every <duration>" descriptor.
+type ConstantDelay struct {
+	Delay time.Duration
+}
+
+// Next returns after advanced by one Delay.
+func (c ConstantDelay) Next(after time.Time) time.Time {
+	return after.Add(c.Delay)
+}
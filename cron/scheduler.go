@@ -0,0 +1,395 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EntryID identifies an entry within a Scheduler. It is returned by AddFunc
+// and AddJob and can be used with Remove.
+type EntryID int
+
+// Job is anything that can be run by the Scheduler. FuncJob adapts a plain
+// func() to satisfy this interface.
+type Job interface {
+	Run()
+}
+
+// FuncJob is a Job implemented by a plain function.
+type FuncJob func()
+
+// Run calls f.
+func (f FuncJob) Run() {
+	f()
+}
+
+// Entry describes a job registered with a Scheduler together with its
+// schedule and the timestamps of its last and next planned runs.
+type Entry struct {
+	// ID is the entry's identifier, unique within its Scheduler.
+	ID EntryID
+
+	// Name is a human readable label for the entry. The Scheduler only
+	// stores it; the logs of the Recover, SkipIfStillRunning and
+	// DelayIfStillRunning wrappers do not include it.
+	Name string
+
+	// Schedule determines when the entry should next run.
+	Schedule Schedule
+
+	// Next is the next time the job will run, or the zero time if the
+	// Scheduler has not been started yet.
+	Next time.Time
+
+	// Prev is the last time the job was run, or the zero time if it has
+	// never been run.
+	Prev time.Time
+
+	// RunAtStart, when set, causes the job to run once as soon as the
+	// Scheduler starts, in addition to its regular schedule.
+	RunAtStart bool
+
+	// job is the chain-wrapped Job that actually gets executed.
+	job Job
+}
+
+// EntryOption configures an Entry at registration time.
+type EntryOption func(*Entry)
+
+// WithRunAtStart marks an entry to run once immediately when the Scheduler
+// starts, before its schedule next comes due.
+func WithRunAtStart() EntryOption {
+	return func(e *Entry) {
+		e.RunAtStart = true
+	}
+}
+
+// Option configures a Scheduler at construction time.
+type Option func(*Scheduler)
+
+// WithChain sets the JobWrapper chain applied to every job registered after
+// this option is set. Wrappers run outermost-first, mirroring NewChain.
+func WithChain(wrappers ...JobWrapper) Option {
+	return func(s *Scheduler) {
+		s.chain = NewChain(wrappers...)
+	}
+}
+
+// Scheduler is a runtime that fires registered jobs according to their
+// CronSerie schedules. It wraps one or more CronSerie entries and drives
+// them from a single goroutine started by Start.
+type Scheduler struct {
+	mu       sync.Mutex
+	entries  []*Entry
+	nextID   EntryID
+	chain    Chain
+	running  bool
+	done     chan struct{} // closed by run when it exits, set fresh by each Start
+	add      chan *Entry
+	remove   chan EntryID
+	stop     chan struct{}
+	jobsDone sync.WaitGroup
+}
+
+// NewScheduler creates a Scheduler ready to have entries added to it.
+func NewScheduler(opts ...Option) *Scheduler {
+	s := &Scheduler{
+		chain:  NewChain(),
+		add:    make(chan *Entry),
+		remove: make(chan EntryID),
+		stop:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// AddFunc registers fn to run on the schedule described by expr and returns
+// the new entry's ID.
+func (s *Scheduler) AddFunc(name, expr string, fn func(), opts ...EntryOption) (EntryID, error) {
+	return s.AddJob(name, expr, FuncJob(fn), opts...)
+}
+
+// AddJob registers j to run on the schedule described by expr and returns
+// the new entry's ID.
+func (s *Scheduler) AddJob(name, expr string, j Job, opts ...EntryOption) (EntryID, error) {
+	schedule, err := NewCronSerie(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	entry := &Entry{
+		ID:       s.nextID,
+		Name:     name,
+		Schedule: schedule,
+		job:      s.chain.Then(j),
+	}
+	running := s.running
+	done := s.done
+	s.mu.Unlock()
+
+	for _, opt := range opts {
+		opt(entry)
+	}
+
+	if running {
+		// run is the only receiver on s.add; if it has already exited
+		// (Stop or ctx cancellation) this send would block forever, so
+		// race it against done instead.
+		select {
+		case s.add <- entry:
+			return entry.ID, nil
+		case <-done:
+		}
+	}
+	s.mu.Lock()
+	s.entries = append(s.entries, entry)
+	s.mu.Unlock()
+	return entry.ID, nil
+}
+
+// Remove stops id from firing in the future.
+func (s *Scheduler) Remove(id EntryID) {
+	s.mu.Lock()
+	running := s.running
+	done := s.done
+	s.mu.Unlock()
+
+	if running {
+		// Same race as AddJob: fall back to a direct removal if run has
+		// already exited and will never receive from s.remove.
+		select {
+		case s.remove <- id:
+			return
+		case <-done:
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = removeEntry(s.entries, id)
+}
+
+// Entries returns a snapshot of the registered entries, sorted by next run
+// time.
+func (s *Scheduler) Entries() []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Entry, len(s.entries))
+	for i, e := range s.entries {
+		out[i] = *e
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Next.Before(out[j].Next) })
+	return out
+}
+
+// Start begins the Scheduler's run loop in its own goroutine and returns
+// immediately. The loop keeps running until ctx is canceled or Stop is
+// called.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = true
+	s.done = make(chan struct{})
+	now := time.Now()
+	for _, e := range s.entries {
+		e.Next = e.Schedule.Next(now)
+	}
+	s.mu.Unlock()
+
+	go s.run(ctx, now)
+}
+
+// Stop halts the Scheduler's run loop and blocks until any in-flight jobs
+// complete. It is safe to call Stop after ctx (passed to Start) has already
+// been canceled, or to call it more than once.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	running := s.running
+	done := s.done
+	s.mu.Unlock()
+
+	if running {
+		// run may have already exited on its own via ctx.Done(); race the
+		// send against done so we don't block forever with no receiver.
+		select {
+		case s.stop <- struct{}{}:
+		case <-done:
+		}
+	}
+	if done != nil {
+		<-done
+	}
+	s.jobsDone.Wait()
+}
+
+// run is the Scheduler's main loop. It sleeps until the soonest entry is
+// due, fires it in its own goroutine, and reschedules it. On return, by
+// whatever path, it marks the Scheduler stopped and closes done so callers
+// blocked sending to add/remove/stop can stop waiting.
+func (s *Scheduler) run(ctx context.Context, now time.Time) {
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		done := s.done
+		s.mu.Unlock()
+		close(done)
+	}()
+
+	s.mu.Lock()
+	for _, e := range s.entries {
+		if e.RunAtStart {
+			e.Prev = now
+			s.startJob(e)
+		}
+	}
+	s.mu.Unlock()
+
+	for {
+		s.mu.Lock()
+		sort.Slice(s.entries, func(i, j int) bool { return s.entries[i].Next.Before(s.entries[j].Next) })
+
+		var timer *time.Timer
+		if len(s.entries) == 0 {
+			timer = time.NewTimer(100000 * time.Hour)
+		} else {
+			timer = time.NewTimer(time.Until(s.entries[0].Next))
+		}
+		s.mu.Unlock()
+
+		select {
+		case now = <-timer.C:
+			s.mu.Lock()
+			for _, e := range s.entries {
+				if e.Next.After(now) {
+					break
+				}
+				e.Prev = e.Next
+				e.Next = e.Schedule.Next(now)
+				s.startJob(e)
+			}
+			s.mu.Unlock()
+
+		case entry := <-s.add:
+			timer.Stop()
+			entry.Next = entry.Schedule.Next(time.Now())
+			s.mu.Lock()
+			s.entries = append(s.entries, entry)
+			s.mu.Unlock()
+
+		case id := <-s.remove:
+			timer.Stop()
+			s.mu.Lock()
+			s.entries = removeEntry(s.entries, id)
+			s.mu.Unlock()
+
+		case <-ctx.Done():
+			timer.Stop()
+			return
+
+		case <-s.stop:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// startJob runs e's job in its own goroutine, tracked by jobsDone so Stop
+// can wait for it to finish.
+func (s *Scheduler) startJob(e *Entry) {
+	s.jobsDone.Add(1)
+	go func() {
+		defer s.jobsDone.Done()
+		e.job.Run()
+	}()
+}
+
+func removeEntry(entries []*Entry, id EntryID) []*Entry {
+	out := entries[:0:0]
+	for _, e := range entries {
+		if e.ID != id {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// JobWrapper decorates a Job with additional behavior, such as recovery
+// from panics or serialization of overlapping runs.
+type JobWrapper func(Job) Job
+
+// Chain is an ordered list of JobWrappers applied to a Job.
+type Chain struct {
+	wrappers []JobWrapper
+}
+
+// NewChain returns a Chain that applies the given wrappers, outermost
+// first, i.e. NewChain(a, b, c).Then(j) runs as a(b(c(j))).
+func NewChain(wrappers ...JobWrapper) Chain {
+	return Chain{wrappers: wrappers}
+}
+
+// Then wraps j with every wrapper in the chain and returns the result. A
+// Chain with no wrappers returns j unchanged.
+func (c Chain) Then(j Job) Job {
+	for i := len(c.wrappers) - 1; i >= 0; i-- {
+		j = c.wrappers[i](j)
+	}
+	return j
+}
+
+// Recover returns a JobWrapper that recovers panics from the wrapped job,
+// logging them to logger instead of letting them crash the Scheduler.
+func Recover(logger *log.Logger) JobWrapper {
+	return func(j Job) Job {
+		return FuncJob(func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Printf("cron: job panicked: %v", r)
+				}
+			}()
+			j.Run()
+		})
+	}
+}
+
+// SkipIfStillRunning returns a JobWrapper that skips an invocation of the
+// wrapped job if the previous invocation hasn't completed yet.
+func SkipIfStillRunning(logger *log.Logger) JobWrapper {
+	return func(j Job) Job {
+		var running sync.Mutex
+		return FuncJob(func() {
+			if !running.TryLock() {
+				logger.Printf("cron: skipping run, still running")
+				return
+			}
+			defer running.Unlock()
+			j.Run()
+		})
+	}
+}
+
+// DelayIfStillRunning returns a JobWrapper that delays an invocation of the
+// wrapped job until the previous invocation has completed.
+func DelayIfStillRunning(logger *log.Logger) JobWrapper {
+	return func(j Job) Job {
+		var mu sync.Mutex
+		return FuncJob(func() {
+			start := time.Now()
+			mu.Lock()
+			defer mu.Unlock()
+			if delay := time.Since(start); delay > time.Second {
+				logger.Printf("cron: run delayed by %v", delay)
+			}
+			j.Run()
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerRunAtStart(t *testing.T) {
+	s := NewScheduler()
+	var calls int32
+	_, err := s.AddFunc("at-start", "0 0 1 1 *", func() {
+		atomic.AddInt32(&calls, 1)
+	}, WithRunAtStart())
+	if err != nil {
+		t.Fatalf("failed to add job: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+	defer s.Stop()
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&calls) == 1 }, time.Second, time.Millisecond)
+}
+
+func TestSchedulerAddAndRemoveWhileRunning(t *testing.T) {
+	s := NewScheduler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+	defer s.Stop()
+
+	id, err := s.AddFunc("noop", "0 0 1 1 *", func() {})
+	if err != nil {
+		t.Fatalf("failed to add job: %v", err)
+	}
+	// The run goroutine appends the entry after receiving it, so it may not
+	// be visible the instant AddFunc returns.
+	assert.Eventually(t, func() bool { return len(s.Entries()) == 1 }, time.Second, time.Millisecond)
+
+	s.Remove(id)
+	assert.Eventually(t, func() bool { return len(s.Entries()) == 0 }, time.Second, time.Millisecond)
+}
+
+func TestSchedulerStopWaitsForInFlightJobs(t *testing.T) {
+	s := NewScheduler()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var finished int32
+
+	_, err := s.AddFunc("slow", "0 0 1 1 *", func() {
+		close(started)
+		<-release
+		atomic.AddInt32(&finished, 1)
+	}, WithRunAtStart())
+	if err != nil {
+		t.Fatalf("failed to add job: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	<-started
+	close(release)
+	s.Stop()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&finished))
+}
+
+// TestSchedulerStopIsIdempotent reproduces the deadlock where Stop sent on
+// the unbuffered stop channel while holding s.mu, and run needed that same
+// lock to reach the select draining it.
+func TestSchedulerStopIsIdempotent(t *testing.T) {
+	s := NewScheduler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		s.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop did not return in time")
+	}
+
+	// A second Stop on an already-stopped Scheduler must not block either.
+	done2 := make(chan struct{})
+	go func() {
+		s.Stop()
+		close(done2)
+	}()
+	select {
+	case <-done2:
+	case <-time.After(2 * time.Second):
+		t.Fatal("second Stop did not return in time")
+	}
+}
+
+// TestSchedulerStopAfterContextCancel reproduces the deadlock where
+// canceling Start's ctx let run exit without clearing running, so a
+// subsequent Stop saw running==true and blocked forever sending on
+// s.stop with no receiver left.
+func TestSchedulerStopAfterContextCancel(t *testing.T) {
+	s := NewScheduler()
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Start(ctx)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop did not return after ctx was canceled")
+	}
+}
+
+// TestSchedulerAddRemoveAfterContextCancel reproduces the same-shaped
+// deadlock in AddJob/Remove: both read running under the lock, release it,
+// then send on an unbuffered channel that run (the sole receiver) may have
+// already stopped servicing.
+func TestSchedulerAddRemoveAfterContextCancel(t *testing.T) {
+	s := NewScheduler()
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Start(ctx)
+	cancel()
+	// Give run a moment to observe ctx.Done() and exit before we race it.
+	time.Sleep(10 * time.Millisecond)
+
+	addDone := make(chan struct{})
+	go func() {
+		_, err := s.AddFunc("late", "0 0 1 1 *", func() {})
+		if err != nil {
+			t.Errorf("AddFunc failed: %v", err)
+		}
+		close(addDone)
+	}()
+	select {
+	case <-addDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("AddFunc did not return after ctx was canceled")
+	}
+
+	removeDone := make(chan struct{})
+	go func() {
+		s.Remove(1)
+		close(removeDone)
+	}()
+	select {
+	case <-removeDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Remove did not return after ctx was canceled")
+	}
+}
+
+func TestRecoverWrapperSwallowsPanic(t *testing.T) {
+	var ran int32
+	runner := Recover(log.New(log.Writer(), "", 0))(FuncJob(func() {
+		atomic.AddInt32(&ran, 1)
+		panic("boom")
+	}))
+
+	assert.NotPanics(t, func() { runner.Run() })
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ran))
+}
+
+func TestSkipIfStillRunningSkipsOverlap(t *testing.T) {
+	release := make(chan struct{})
+	var runs int32
+	job := SkipIfStillRunning(log.New(log.Writer(), "", 0))(FuncJob(func() {
+		atomic.AddInt32(&runs, 1)
+		<-release
+	}))
+
+	go job.Run()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&runs) == 1 }, time.Second, time.Millisecond)
+
+	// A second, overlapping invocation should be skipped rather than block.
+	done := make(chan struct{})
+	go func() {
+		job.Run()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("overlapping run was not skipped")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&runs))
+
+	close(release)
+}
+
+func TestChainThenAppliesOutermostFirst(t *testing.T) {
+	var order []string
+	wrap := func(name string) JobWrapper {
+		return func(j Job) Job {
+			return FuncJob(func() {
+				order = append(order, name)
+				j.Run()
+			})
+		}
+	}
+	job := NewChain(wrap("a"), wrap("b"), wrap("c")).Then(FuncJob(func() {}))
+	job.Run()
+	assert.Equal(t, []string{"a", "b", "c"}, order)
+}
+
+func TestSchedulerWithChainWrapsRegisteredJobs(t *testing.T) {
+	var panicked int32
+	s := NewScheduler(WithChain(Recover(log.New(log.Writer(), "", 0))))
+	_, err := s.AddFunc("panics", "0 0 1 1 *", func() {
+		atomic.AddInt32(&panicked, 1)
+		panic("boom")
+	}, WithRunAtStart())
+	if err != nil {
+		t.Fatalf("failed to add job: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+	defer s.Stop()
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&panicked) == 1 }, time.Second, time.Millisecond)
+}
+
+func TestDelayIfStillRunningSerializes(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	wrapped := DelayIfStillRunning(log.New(log.Writer(), "", 0))(FuncJob(func() {
+		mu.Lock()
+		order = append(order, "run")
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { defer wg.Done(); wrapped.Run() }()
+	go func() { defer wg.Done(); wrapped.Run() }()
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, order, 2)
+}
@@ -9,47 +9,172 @@ import (
 
 // CronSerie represents a parsed cron expression and stores allowed values for each field.
 type CronSerie struct {
-	minutes [60]bool // Allowed minutes (0-59)
-	hours   [24]bool // Allowed hours (0-23)
-	dom     [32]bool // Allowed days of month (1-31, 0 unused)
-	months  [13]bool // Allowed months (1-12, 0 unused)
-	dow     [7]bool  // Allowed days of week (0=Sunday)
-	expr    string   // Original cron expression
+	seconds    [60]bool // Allowed seconds (0-59), only consulted when hasSeconds is set
+	hasSeconds bool     // Whether expr carried a leading seconds field
+	minutes    [60]bool // Allowed minutes (0-59)
+	hours      [24]bool // Allowed hours (0-23)
+	domMatcher domMatcher
+	months     [13]bool       // Allowed months (1-12, 0 unused)
+	dow        [7]bool        // Allowed days of week (0=Sunday)
+	expr       string         // Original cron expression
+	loc        *time.Location // Location to evaluate the schedule in, or nil to use after's location
 }
 
 // NewCronSerie parses a standard 5-field cron expression and returns a CronSerie.
 // Returns an error if the expression is invalid.
 func NewCronSerie(expr string) (*CronSerie, error) {
+	return newCronSerie(expr, nil, false)
+}
+
+// NewCronSerieInLocation parses a standard 5-field cron expression and
+// returns a CronSerie that always evaluates its schedule in loc, regardless
+// of the location of the 'after' time passed to Next. This makes the
+// schedule resilient to callers in other time zones and lets it navigate
+// loc's own DST transitions correctly.
+func NewCronSerieInLocation(expr string, loc *time.Location) (*CronSerie, error) {
+	return newCronSerie(expr, loc, false)
+}
+
+func newCronSerie(expr string, loc *time.Location, hasSeconds bool) (*CronSerie, error) {
 	fields := strings.Fields(expr)
-	if len(fields) != 5 {
-		return nil, fmt.Errorf("invalid cron expression: must have 5 fields")
+	wantFields := 5
+	if hasSeconds {
+		wantFields = 6
 	}
-	c := &CronSerie{expr: expr}
-	if err := parseField(fields[0], 0, 59, c.minutes[:]); err != nil {
-		return nil, fmt.Errorf("minute: %w", err)
+	if len(fields) != wantFields {
+		return nil, &ParseError{
+			Field:    "expression",
+			Token:    expr,
+			Position: 0,
+			Reason:   fmt.Sprintf("must have %d fields, found %d", wantFields, len(fields)),
+		}
 	}
-	if err := parseField(fields[1], 0, 23, c.hours[:]); err != nil {
-		return nil, fmt.Errorf("hour: %w", err)
+	positions := fieldPositions(expr, fields)
+	c := &CronSerie{expr: expr, loc: loc, hasSeconds: hasSeconds}
+	idx := 0
+	if hasSeconds {
+		if err := parseField("second", positions[0], fields[0], 0, 59, c.seconds[:]); err != nil {
+			return nil, err
+		}
+		idx = 1
+	}
+	if err := parseField("minute", positions[idx], fields[idx], 0, 59, c.minutes[:]); err != nil {
+		return nil, err
 	}
-	if err := parseField(fields[2], 1, 31, c.dom[:]); err != nil {
-		return nil, fmt.Errorf("day of month: %w", err)
+	if err := parseField("hour", positions[idx+1], fields[idx+1], 0, 23, c.hours[:]); err != nil {
+		return nil, err
 	}
-	if err := parseField(fields[3], 1, 12, c.months[:]); err != nil {
-		return nil, fmt.Errorf("month: %w", err)
+	domMatcher, err := parseDomField(positions[idx+2], fields[idx+2])
+	if err != nil {
+		return nil, err
+	}
+	c.domMatcher = domMatcher
+	monthField := replaceNames(fields[idx+3], monthNames)
+	if err := parseField("month", positions[idx+3], monthField, 1, 12, c.months[:]); err != nil {
+		if pe, ok := err.(*ParseError); ok && monthField != fields[idx+3] {
+			// Names were rewritten to numbers; point at the field as written.
+			pe.Token, pe.Position = fields[idx+3], positions[idx+3]
+		}
+		return nil, err
 	}
-	if err := parseField(fields[4], 0, 6, c.dow[:]); err != nil {
-		return nil, fmt.Errorf("day of week: %w", err)
+	dowField := fields[idx+4]
+	if dowField == "?" {
+		dowField = "*"
+	}
+	dowField = replaceNames(dowField, dowNames)
+	if err := parseField("dow", positions[idx+4], dowField, 0, 6, c.dow[:]); err != nil {
+		if pe, ok := err.(*ParseError); ok && dowField != fields[idx+4] {
+			// Names were rewritten to numbers; point at the field as written.
+			pe.Token, pe.Position = fields[idx+4], positions[idx+4]
+		}
+		return nil, err
 	}
 	return c, nil
 }
 
+// fieldPositions returns the byte offset of each whitespace-separated field
+// within expr, in the same order as strings.Fields(expr) (which fields must
+// equal).
+func fieldPositions(expr string, fields []string) []int {
+	positions := make([]int, len(fields))
+	offset := 0
+	for i, f := range fields {
+		idx := strings.Index(expr[offset:], f)
+		positions[i] = offset + idx
+		offset = positions[i] + len(f)
+	}
+	return positions
+}
+
+// monthNames and dowNames map cron field positions to their Quartz-style
+// three-letter names, so expressions can spell out "JAN-DEC" or
+// "MON,WED,FRI" instead of numbers. Index 0 of monthNames is unused since
+// months are 1-based.
+var monthNames = []string{"", "JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+var dowNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// replaceNames case-insensitively replaces each name in names with its
+// index, so a field like "MON-FRI" can be fed to parseField as "1-5".
+func replaceNames(field string, names []string) string {
+	upper := strings.ToUpper(field)
+	for i, name := range names {
+		if name == "" {
+			continue
+		}
+		upper = strings.ReplaceAll(upper, name, strconv.Itoa(i))
+	}
+	return upper
+}
+
+// parseDomField parses the day-of-month field, which in addition to the
+// lists, ranges, steps and wildcards handled by parseField also accepts the
+// Quartz-style tokens "L" (last day of the month), "L-n" (n days before the
+// end of the month), "nW" (nearest weekday to the nth day) and "?" (treated
+// as a wildcard).
+func parseDomField(pos int, field string) (domMatcher, error) {
+	switch {
+	case field == "*" || field == "?" || field == "":
+		arr := &arrayDomMatcher{}
+		for d := 1; d <= 31; d++ {
+			arr.days[d] = true
+		}
+		return arr, nil
+	case field == "L":
+		return lastDayMatcher{}, nil
+	case strings.HasPrefix(field, "L-"):
+		n, err := strconv.Atoi(field[2:])
+		if err != nil || n < 0 {
+			return nil, &ParseError{Field: "dom", Token: field, Position: pos, Reason: "invalid L-n token"}
+		}
+		return lastDayOffsetMatcher{offset: n}, nil
+	case strings.HasSuffix(field, "W"):
+		n, err := strconv.Atoi(strings.TrimSuffix(field, "W"))
+		if err != nil || n < 1 || n > 31 {
+			return nil, &ParseError{Field: "dom", Token: field, Position: pos, Reason: "invalid nW token"}
+		}
+		return nearestWeekdayMatcher{day: n}, nil
+	default:
+		arr := &arrayDomMatcher{}
+		if err := parseField("dom", pos, field, 1, 31, arr.days[:]); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+}
+
 // parseField populates the boolean array for a single cron field.
 // Supports wildcards (*), ranges (x-y), steps (/), and comma-separated lists.
-// Returns an error if the field is invalid.
-func parseField(field string, min, max int, arr []bool) error {
-	parts := strings.Split(field, ",")
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
+// fieldName and pos (the field's byte offset within the original
+// expression) are used to annotate any *ParseError returned.
+func parseField(fieldName string, pos int, field string, min, max int, arr []bool) error {
+	offset := 0
+	for _, rawPart := range strings.Split(field, ",") {
+		partPos := pos + offset
+		offset += len(rawPart) + 1 // +1 for the comma
+		part := strings.TrimLeft(rawPart, " \t")
+		partPos += len(rawPart) - len(part)
+		part = strings.TrimRight(part, " \t")
+
 		step := 1
 		rangePart := part
 
@@ -60,7 +185,12 @@ func parseField(field string, min, max int, arr []bool) error {
 			var err error
 			step, err = strconv.Atoi(subs[1])
 			if err != nil || step <= 0 {
-				return fmt.Errorf("invalid step value: %s", subs[1])
+				return &ParseError{
+					Field:    fieldName,
+					Token:    subs[1],
+					Position: partPos + len(rangePart) + 1,
+					Reason:   "invalid step value",
+				}
 			}
 		}
 
@@ -74,12 +204,22 @@ func parseField(field string, min, max int, arr []bool) error {
 			rmin, err1 = strconv.Atoi(bounds[0])
 			rmax, err2 = strconv.Atoi(bounds[1])
 			if err1 != nil || err2 != nil || rmin > rmax || rmin < min || rmax > max {
-				return fmt.Errorf("invalid range: %s", rangePart)
+				return &ParseError{
+					Field:    fieldName,
+					Token:    rangePart,
+					Position: partPos,
+					Reason:   "invalid range",
+				}
 			}
 		} else {
 			val, err := strconv.Atoi(rangePart)
 			if err != nil || val < min || val > max {
-				return fmt.Errorf("invalid value: %s", rangePart)
+				return &ParseError{
+					Field:    fieldName,
+					Token:    rangePart,
+					Position: partPos,
+					Reason:   fmt.Sprintf("value must be between %d and %d", min, max),
+				}
 			}
 			rmin, rmax = val, val
 		}
@@ -103,10 +243,19 @@ func (c *CronSerie) Next(after time.Time) time.Time {
 
 // next computes the next time that matches the cron schedule after the given
 // time. It advances through each field in order: month, day, day-of-week,
-// hour, minute. This version guarantees that the returned time is strictly
-// after 'after'.
+// hour, minute, and (when hasSeconds is set) second. This version guarantees
+// that the returned time is strictly after 'after'.
 func (c *CronSerie) next(after time.Time) time.Time {
-	t := after.Add(time.Minute).Truncate(time.Minute)
+	loc := c.loc
+	if loc == nil {
+		loc = after.Location()
+	}
+	after = after.In(loc)
+	granularity := time.Minute
+	if c.hasSeconds {
+		granularity = time.Second
+	}
+	t := after.Add(granularity).Truncate(granularity)
 	for {
 		// Advance month if not allowed or if t <= after
 		if !c.months[int(t.Month())] || !t.After(after) {
@@ -118,7 +267,11 @@ func (c *CronSerie) next(after time.Time) time.Time {
 				}
 				for m := startMonth; m <= 12; m++ {
 					if c.months[m] {
-						cand := time.Date(y, time.Month(m), 1, 0, 0, 0, 0, t.Location())
+						d := c.domMatcher.firstMatch(y, time.Month(m))
+						if d == 0 {
+							d = 1
+						}
+						cand := time.Date(y, time.Month(m), d, 0, 0, 0, 0, loc)
 						if cand.After(after) {
 							t = cand
 							found = true
@@ -134,21 +287,19 @@ func (c *CronSerie) next(after time.Time) time.Time {
 		}
 		// Advance day of month if not allowed or if t <= after
 		daysInCurrMonth := daysInMonth(t.Year(), t.Month())
-		if !c.dom[t.Day()] || !t.After(after) {
+		if !c.domMatcher.match(t) || !t.After(after) {
 			found := false
 			for d := t.Day(); d <= daysInCurrMonth; d++ {
-				if c.dom[d] {
-					cand := time.Date(t.Year(), t.Month(), d, 0, 0, 0, 0, t.Location())
-					if cand.After(after) {
-						t = cand
-						found = true
-						break
-					}
+				cand := time.Date(t.Year(), t.Month(), d, 0, 0, 0, 0, loc)
+				if c.domMatcher.match(cand) && cand.After(after) {
+					t = cand
+					found = true
+					break
 				}
 			}
 			if !found {
-				// Go to the first day of next allowed month
-				t = time.Date(t.Year(), t.Month(), daysInCurrMonth, 23, 59, 0, 0, t.Location()).Add(time.Minute)
+				// Go to the first day of next month
+				t = time.Date(t.Year(), t.Month(), daysInCurrMonth+1, 0, 0, 0, 0, loc)
 			}
 			continue
 		}
@@ -157,9 +308,9 @@ func (c *CronSerie) next(after time.Time) time.Time {
 			found := false
 			for i := 1; i <= 7; i++ {
 				nd := t.AddDate(0, 0, i)
-				if c.dow[int(nd.Weekday())] && c.dom[nd.Day()] && c.months[int(nd.Month())] {
+				if c.dow[int(nd.Weekday())] && c.domMatcher.match(nd) && c.months[int(nd.Month())] {
 					if nd.After(after) {
-						t = time.Date(nd.Year(), nd.Month(), nd.Day(), 0, 0, 0, 0, t.Location())
+						t = time.Date(nd.Year(), nd.Month(), nd.Day(), 0, 0, 0, 0, loc)
 						found = true
 						break
 					}
@@ -176,17 +327,31 @@ func (c *CronSerie) next(after time.Time) time.Time {
 			found := false
 			for h := t.Hour(); h < 24; h++ {
 				if c.hours[h] {
-					cand := time.Date(t.Year(), t.Month(), t.Day(), h, 0, 0, 0, t.Location())
-					if cand.After(after) {
-						t = cand
+					cand, skipped := dateIn(t.Year(), t.Month(), t.Day(), h, 0, loc)
+					if !cand.After(after) {
+						continue
+					}
+					if skipped {
+						// h doesn't exist today (DST spring-forward); cand
+						// lands on the first valid wall clock on/after it.
+						// Still honor the minute (and second) fields against
+						// that substituted hour rather than firing on
+						// whichever minute dateIn happened to normalize to.
+						if next, ok := firstMatchInHour(c, cand, after, loc); ok {
+							return next
+						}
+						t = time.Date(cand.Year(), cand.Month(), cand.Day(), cand.Hour()+1, 0, 0, 0, loc)
 						found = true
 						break
 					}
+					t = cand
+					found = true
+					break
 				}
 			}
 			if !found {
 				// Go to next day
-				t = time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 0, 0, t.Location()).Add(time.Minute)
+				t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
 			}
 			continue
 		}
@@ -195,7 +360,37 @@ func (c *CronSerie) next(after time.Time) time.Time {
 			found := false
 			for m := t.Minute(); m < 60; m++ {
 				if c.minutes[m] {
-					cand := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), m, 0, 0, t.Location())
+					cand, skipped := dateIn(t.Year(), t.Month(), t.Day(), t.Hour(), m, loc)
+					if !cand.After(after) {
+						continue
+					}
+					if skipped {
+						// t.Hour():m doesn't exist today (DST
+						// spring-forward); cand is already the first valid
+						// instant on or after it.
+						return cand
+					}
+					t = cand
+					found = true
+					break
+				}
+			}
+			if !found {
+				// Go to the next wall-clock hour. Reconstructing the hour
+				// explicitly (rather than adding a real 60-t.Minute()
+				// duration) keeps this correct across a fall-back DST
+				// transition, where adding elapsed time could otherwise
+				// land back on the hour just exhausted, repeated.
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			}
+			continue
+		}
+		// Advance second if enabled and not allowed or if t <= after
+		if c.hasSeconds && (!c.seconds[t.Second()] || !t.After(after)) {
+			found := false
+			for s := t.Second(); s < 60; s++ {
+				if c.seconds[s] {
+					cand := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), s, 0, loc)
 					if cand.After(after) {
 						t = cand
 						found = true
@@ -204,8 +399,8 @@ func (c *CronSerie) next(after time.Time) time.Time {
 				}
 			}
 			if !found {
-				// Go to next hour
-				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 59, 0, 0, t.Location()).Add(time.Minute)
+				// Go to the next minute.
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
 			}
 			continue
 		}
@@ -214,6 +409,36 @@ func (c *CronSerie) next(after time.Time) time.Time {
 	}
 }
 
+// firstMatchInHour searches hourStart's hour (and, if c.hasSeconds, its
+// seconds) for the first instant that satisfies c's minute/second fields
+// and is after `after`. hourStart is assumed to already sit on an allowed
+// day; it exists to let the DST spring-forward path in next validate the
+// minute/second fields against an hour substituted for one that doesn't
+// exist, rather than returning that hour's first minute unconditionally.
+func firstMatchInHour(c *CronSerie, hourStart time.Time, after time.Time, loc *time.Location) (time.Time, bool) {
+	for m := hourStart.Minute(); m < 60; m++ {
+		if !c.minutes[m] {
+			continue
+		}
+		cand, skipped := dateIn(hourStart.Year(), hourStart.Month(), hourStart.Day(), hourStart.Hour(), m, loc)
+		if skipped || !cand.After(after) {
+			continue
+		}
+		if !c.hasSeconds {
+			return cand, true
+		}
+		for s := 0; s < 60; s++ {
+			if c.seconds[s] {
+				scand := time.Date(cand.Year(), cand.Month(), cand.Day(), cand.Hour(), cand.Minute(), s, 0, loc)
+				if scand.After(after) {
+					return scand, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
 // daysInMonth returns the number of days in a given month of a specific year.
 func daysInMonth(year int, month time.Month) int {
 	switch month {
@@ -66,7 +66,37 @@ func TestCronSerie(t *testing.T) {
 			name:  "At the 20th day of the month",
 			expr:  "0 0 20 * *",
 			after: "2025-08-13 00:00:00",
-			want:  "2025-08-17 00:00:00", // Next Sunday
+			want:  "2025-08-20 00:00:00",
+		},
+		{
+			name:  "Last day of February, non-leap year",
+			expr:  "0 0 L * *",
+			after: "2025-02-01 00:00:00",
+			want:  "2025-02-28 00:00:00",
+		},
+		{
+			name:  "Last day of February, leap year",
+			expr:  "0 0 L * *",
+			after: "2024-02-01 00:00:00",
+			want:  "2024-02-29 00:00:00",
+		},
+		{
+			name:  "L-3 three days before the end of April",
+			expr:  "0 0 L-3 * *",
+			after: "2025-04-01 00:00:00",
+			want:  "2025-04-27 00:00:00",
+		},
+		{
+			name:  "15W nearest weekday falls back over a weekend",
+			expr:  "0 0 15W * *",
+			after: "2025-11-01 00:00:00",
+			want:  "2025-11-14 00:00:00", // the 15th is a Saturday
+		},
+		{
+			name:  "31W clamps to the last day instead of rolling into next month",
+			expr:  "0 0 31W * *",
+			after: "2025-04-01 00:00:00",
+			want:  "2025-04-30 00:00:00", // April only has 30 days
 		},
 	}
 
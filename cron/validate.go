@@ -0,0 +1,23 @@
+package cron
+
+import "time"
+
+// Validate reports whether expr is a well-formed 5-field cron expression,
+// without requiring the caller to hold on to a CronSerie. On failure, the
+// returned error can be inspected with errors.As for a *ParseError.
+func Validate(expr string) error {
+	_, err := NewCronSerie(expr)
+	return err
+}
+
+// NextN returns the next n times the schedule fires after the given time,
+// in order. It's intended for previewing a cron expression, e.g. when
+// wiring it into a configuration form.
+func (c *CronSerie) NextN(after time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	for i := 0; i < n; i++ {
+		after = c.Next(after)
+		times = append(times, after)
+	}
+	return times
+}
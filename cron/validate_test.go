@@ -0,0 +1,54 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("0 0 1 1 *"))
+
+	err := Validate("0 99 * * *")
+	assert.Error(t, err)
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	assert.Equal(t, "hour", parseErr.Field)
+	assert.Equal(t, "99", parseErr.Token)
+}
+
+func TestNewCronSerieReturnsParseError(t *testing.T) {
+	_, err := NewCronSerie("0 0 1 1 8")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	assert.Equal(t, "dow", parseErr.Field)
+	assert.Equal(t, "8", parseErr.Token)
+}
+
+func TestCronSerieNextN(t *testing.T) {
+	serie, err := NewCronSerie("0 * * * *")
+	if err != nil {
+		t.Fatalf("failed to create cron serie: %v", err)
+	}
+	after := mustParseTime(t, "2006-01-02 15:04:05", "2025-08-15 12:01:00")
+	got := serie.NextN(after, 3)
+
+	want := []string{
+		"2025-08-15 13:00:00",
+		"2025-08-15 14:00:00",
+		"2025-08-15 15:00:00",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d times, got %d", len(want), len(got))
+	}
+	for i, w := range want {
+		assert.Equal(t, mustParseTime(t, "2006-01-02 15:04:05", w), got[i])
+	}
+}